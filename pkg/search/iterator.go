@@ -0,0 +1,127 @@
+package search
+
+// RepositoryIterator fetches GitHub repository search results one page at a
+// time, following the `Link: rel="next"` response header, instead of
+// accumulating every item into memory up front. This lets callers process
+// very large result sets and abort early via Close.
+type RepositoryIterator struct {
+	searcher *searcher
+	query    Query
+
+	items   []Repository
+	pos     int
+	yielded int
+	started bool
+	closed  bool
+	err     error
+
+	incompleteResults bool
+	total             int
+	lastPage          int
+}
+
+// RepositoriesIter returns a RepositoryIterator over query's results. If
+// query.PageSize is unset it defaults to 100, GitHub's maximum page size.
+func (s *searcher) RepositoriesIter(query Query) *RepositoryIterator {
+	query.Kind = KindRepositories
+	if query.PageSize <= 0 {
+		query.PageSize = maxPerPage
+	}
+	return &RepositoryIterator{searcher: s, query: query, pos: -1}
+}
+
+// Next advances the iterator to the next result, fetching additional pages
+// as needed. It returns false once results are exhausted, query.Limit (if
+// set) has been reached, or an error occurs; use Err to tell them apart.
+func (it *RepositoryIterator) Next() bool {
+	if it.err != nil || it.closed {
+		return false
+	}
+	if it.query.Limit > 0 && it.yielded >= it.query.Limit {
+		return false
+	}
+	if it.pos+1 < len(it.items) {
+		it.pos++
+		it.yielded++
+		return true
+	}
+	if it.started && it.query.Page == 0 {
+		return false
+	}
+	if err := it.fetchPage(); err != nil {
+		it.err = err
+		return false
+	}
+	if len(it.items) == 0 {
+		return false
+	}
+	it.pos = 0
+	it.yielded++
+	return true
+}
+
+// Item returns the result at the iterator's current position. It is only
+// valid to call after a call to Next has returned true.
+func (it *RepositoryIterator) Item() Repository {
+	return it.items[it.pos]
+}
+
+// Page returns the page number the iterator most recently fetched, or 0
+// before the first call to Next.
+func (it *RepositoryIterator) Page() int {
+	return it.lastPage
+}
+
+// Err returns the first error encountered while fetching pages, if any.
+func (it *RepositoryIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iterator, releasing its buffered page. Callers may stop
+// iterating early by breaking out of their loop and calling Close; it is
+// also safe to defer unconditionally.
+func (it *RepositoryIterator) Close() {
+	it.closed = true
+	it.items = nil
+}
+
+func (it *RepositoryIterator) fetchPage() error {
+	it.started = true
+	perPage := it.query.PageSize
+	if it.query.Limit > 0 {
+		if remaining := it.query.Limit - it.yielded; remaining < perPage {
+			perPage = remaining
+		}
+	}
+	pageNum := it.query.Page
+	if pageNum == 0 {
+		pageNum = 1
+	}
+	pageQuery := it.query
+	pageQuery.Page = pageNum
+	pageQuery.Limit = perPage
+	values, err := it.searcher.values(pageQuery)
+	if err != nil {
+		return err
+	}
+	var result RepositoriesResult
+	linkHeader, err := it.searcher.fetch(KindRepositories, values, pageQuery, &result)
+	if err != nil {
+		return err
+	}
+	it.items = result.Items
+	it.incompleteResults = result.IncompleteResults
+	it.total = result.Total
+	it.lastPage = pageQuery.Page
+
+	nextURL := findNextPage(linkHeader)
+	it.query.Page = 0
+	if nextURL != "" {
+		nextPage, err := pageFromURL(nextURL)
+		if err != nil {
+			return err
+		}
+		it.query.Page = nextPage
+	}
+	return nil
+}
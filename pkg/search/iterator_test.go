@@ -0,0 +1,87 @@
+package search
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepositoryIterator(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	firstReq := httpmock.QueryMatcher("GET", "search/repositories", url.Values{
+		"page":     []string{"1"},
+		"per_page": []string{"30"},
+		"order":    []string{"stars"},
+		"sort":     []string{"desc"},
+		"q":        []string{"keyword stars:>=5 topic:topic"},
+	})
+	firstRes := httpmock.JSONResponse(RepositoriesResult{
+		Items: []Repository{{Name: "test"}},
+		Total: 2,
+	})
+	firstRes = httpmock.WithHeader(firstRes, "Link", `<https://api.github.com/search/repositories?page=2&per_page=100&q=org%3Agithub>; rel="next"`)
+	reg.Register(firstReq, firstRes)
+
+	secondReq := httpmock.QueryMatcher("GET", "search/repositories", url.Values{
+		"page":     []string{"2"},
+		"per_page": []string{"29"},
+		"order":    []string{"stars"},
+		"sort":     []string{"desc"},
+		"q":        []string{"keyword stars:>=5 topic:topic"},
+	})
+	secondRes := httpmock.JSONResponse(RepositoriesResult{
+		Items: []Repository{{Name: "cli"}},
+		Total: 2,
+	})
+	reg.Register(secondReq, secondRes)
+
+	client := &http.Client{Transport: reg}
+	searcher := NewSearcher(client, "github.com")
+	it := searcher.RepositoriesIter(query)
+	defer it.Close()
+
+	var names []string
+	for it.Next() {
+		names = append(names, it.Item().Name)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"test", "cli"}, names)
+	assert.Equal(t, 2, it.Page())
+}
+
+func TestRepositoryIteratorClose(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.QueryMatcher("GET", "search/repositories", url.Values{
+			"page":     []string{"1"},
+			"per_page": []string{"30"},
+			"order":    []string{"stars"},
+			"sort":     []string{"desc"},
+			"q":        []string{"keyword stars:>=5 topic:topic"},
+		}),
+		httpmock.WithHeader(
+			httpmock.JSONResponse(RepositoriesResult{
+				Items: []Repository{{Name: "test"}, {Name: "cli"}},
+				Total: 2,
+			}),
+			"Link", `<https://api.github.com/search/repositories?page=2&per_page=100&q=org%3Agithub>; rel="next"`,
+		),
+	)
+
+	client := &http.Client{Transport: reg}
+	searcher := NewSearcher(client, "github.com")
+	it := searcher.RepositoriesIter(query)
+
+	assert.True(t, it.Next())
+	assert.Equal(t, "test", it.Item().Name)
+	it.Close()
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+}
@@ -0,0 +1,258 @@
+package search
+
+import (
+	"fmt"
+	"time"
+)
+
+// Range describes a GitHub search range qualifier value, e.g. ">100",
+// "<=5" or "5..10".
+type Range struct {
+	Operator string // one of "", ">", ">=", "<", "<=", ".."
+	Value    string
+	End      string // only used when Operator is ".."
+}
+
+func (r Range) String() string {
+	if r.Operator == ".." {
+		return fmt.Sprintf("%s..%s", r.Value, r.End)
+	}
+	return r.Operator + r.Value
+}
+
+// Between returns a Range matching values from lo to hi inclusive, e.g.
+// Between("5", "10") renders as "5..10".
+func Between(lo, hi string) Range {
+	return Range{Operator: "..", Value: lo, End: hi}
+}
+
+// qualifierKinds restricts a qualifier to the Query.Kind values it is valid
+// for. A qualifier with no entry here is allowed for every kind.
+var qualifierKinds = map[string][]string{
+	"author":         {KindCommits, KindIssues},
+	"author-date":    {KindCommits},
+	"committer":      {KindCommits},
+	"committer-date": {KindCommits},
+	"assignee":       {KindIssues},
+	"state":          {KindIssues},
+	"label":          {KindIssues},
+	"milestone":      {KindIssues},
+	"reason":         {KindIssues},
+	"extension":      {KindCode},
+	"filename":       {KindCode},
+	"path":           {KindCode},
+	"location":       {KindUsers},
+	"repos":          {KindUsers},
+	"topic":          {KindRepositories},
+	"archived":       {KindRepositories},
+	"stars":          {KindRepositories},
+	"forks":          {KindRepositories},
+	"license":        {KindRepositories},
+	"language":       {KindRepositories, KindCode, KindUsers},
+	"size":           {KindRepositories, KindCode},
+	"followers":      {KindRepositories, KindUsers},
+	"repositories":   {KindTopics},
+}
+
+// QualifiersBuilder builds a Qualifiers value through a chainable,
+// fluent API instead of setting struct fields directly. Construct one with
+// NewQualifiers.
+type QualifiersBuilder struct {
+	kind       string
+	qualifiers Qualifiers
+	negateNext bool
+	err        error
+}
+
+// NewQualifiers returns an empty QualifiersBuilder.
+func NewQualifiers() *QualifiersBuilder {
+	return &QualifiersBuilder{}
+}
+
+// ForKind restricts the builder to qualifiers valid for the given Query.Kind
+// (e.g. KindCommits), rejecting any others via Err.
+func (b *QualifiersBuilder) ForKind(kind string) *QualifiersBuilder {
+	b.kind = kind
+	return b
+}
+
+// Not negates the next qualifier set on the builder, e.g.
+// NewQualifiers().Not().Language("go") produces "-language:go". Boolean
+// qualifiers such as Archived cannot be negated; see Archived.
+func (b *QualifiersBuilder) Not() *QualifiersBuilder {
+	b.negateNext = true
+	return b
+}
+
+// Err returns the first error recorded while building, such as a qualifier
+// that the selected Kind does not support.
+func (b *QualifiersBuilder) Err() error {
+	return b.err
+}
+
+// Build returns the Qualifiers assembled so far. Any rejected qualifier
+// calls are simply omitted; inspect Err to find out whether that happened.
+func (b *QualifiersBuilder) Build() Qualifiers {
+	return b.qualifiers
+}
+
+func (b *QualifiersBuilder) allowed(name string) bool {
+	kinds, restricted := qualifierKinds[name]
+	if !restricted || b.kind == "" {
+		return true
+	}
+	for _, k := range kinds {
+		if k == b.kind {
+			return true
+		}
+	}
+	if b.err == nil {
+		b.err = fmt.Errorf("qualifier %q is not valid for search kind %q", name, b.kind)
+	}
+	return false
+}
+
+func (b *QualifiersBuilder) negated(value string) string {
+	if b.negateNext {
+		b.negateNext = false
+		return "-" + value
+	}
+	return value
+}
+
+// negatedAll applies a pending Not() to every value being appended to a
+// slice qualifier in this call, consuming the flag just once, e.g.
+// NewQualifiers().Not().Label("bug", "p1") produces "-bug" and "-p1".
+func (b *QualifiersBuilder) negatedAll(values []string) []string {
+	if !b.negateNext {
+		return values
+	}
+	b.negateNext = false
+	negated := make([]string, len(values))
+	for i, value := range values {
+		negated[i] = "-" + value
+	}
+	return negated
+}
+
+// Language sets the language: qualifier.
+func (b *QualifiersBuilder) Language(value string) *QualifiersBuilder {
+	if b.allowed("language") {
+		b.qualifiers.Language = b.negated(value)
+	}
+	return b
+}
+
+// Stars sets the stars: qualifier, e.g. Stars(Range{Operator: ">", Value: "100"}).
+func (b *QualifiersBuilder) Stars(r Range) *QualifiersBuilder {
+	if b.allowed("stars") {
+		b.qualifiers.Stars = b.negated(r.String())
+	}
+	return b
+}
+
+// Forks sets the forks: qualifier.
+func (b *QualifiersBuilder) Forks(r Range) *QualifiersBuilder {
+	if b.allowed("forks") {
+		b.qualifiers.Forks = b.negated(r.String())
+	}
+	return b
+}
+
+// Topic appends to the topic: qualifier.
+func (b *QualifiersBuilder) Topic(values ...string) *QualifiersBuilder {
+	if b.allowed("topic") {
+		b.qualifiers.Topic = append(b.qualifiers.Topic, b.negatedAll(values)...)
+	}
+	return b
+}
+
+// Repositories sets the repositories: qualifier, filtering topics by how
+// many repositories are tagged with them.
+func (b *QualifiersBuilder) Repositories(r Range) *QualifiersBuilder {
+	if b.allowed("repositories") {
+		b.qualifiers.Repositories = b.negated(r.String())
+	}
+	return b
+}
+
+// Archived sets the archived: qualifier. Booleans have only two states, so
+// a preceding Not() is rejected via Err rather than silently dropped; call
+// Archived(false) directly for the negative case instead.
+func (b *QualifiersBuilder) Archived(archived bool) *QualifiersBuilder {
+	if b.allowed("archived") {
+		if b.negateNext {
+			b.negateNext = false
+			if b.err == nil {
+				b.err = fmt.Errorf("qualifier %q is a boolean and cannot be negated; call Archived(false) instead", "archived")
+			}
+		} else {
+			b.qualifiers.Archived = &archived
+		}
+	}
+	return b
+}
+
+// Author sets the author: qualifier.
+func (b *QualifiersBuilder) Author(value string) *QualifiersBuilder {
+	if b.allowed("author") {
+		b.qualifiers.Author = b.negated(value)
+	}
+	return b
+}
+
+// Committer sets the committer: qualifier.
+func (b *QualifiersBuilder) Committer(value string) *QualifiersBuilder {
+	if b.allowed("committer") {
+		b.qualifiers.Committer = b.negated(value)
+	}
+	return b
+}
+
+// Is appends to the is: qualifier.
+func (b *QualifiersBuilder) Is(values ...string) *QualifiersBuilder {
+	if b.allowed("is") {
+		b.qualifiers.Is = append(b.qualifiers.Is, b.negatedAll(values)...)
+	}
+	return b
+}
+
+// Label appends to the label: qualifier.
+func (b *QualifiersBuilder) Label(values ...string) *QualifiersBuilder {
+	if b.allowed("label") {
+		b.qualifiers.Label = append(b.qualifiers.Label, b.negatedAll(values)...)
+	}
+	return b
+}
+
+// State sets the state: qualifier.
+func (b *QualifiersBuilder) State(value string) *QualifiersBuilder {
+	if b.allowed("state") {
+		b.qualifiers.State = b.negated(value)
+	}
+	return b
+}
+
+// Location sets the location: qualifier.
+func (b *QualifiersBuilder) Location(value string) *QualifiersBuilder {
+	if b.allowed("location") {
+		b.qualifiers.Location = b.negated(value)
+	}
+	return b
+}
+
+// Followers sets the followers: qualifier.
+func (b *QualifiersBuilder) Followers(r Range) *QualifiersBuilder {
+	if b.allowed("followers") {
+		b.qualifiers.Followers = b.negated(r.String())
+	}
+	return b
+}
+
+// UpdatedBetween sets the updated: qualifier to the date range [start, end].
+func (b *QualifiersBuilder) UpdatedBetween(start, end time.Time) *QualifiersBuilder {
+	if b.allowed("updated") {
+		b.qualifiers.Updated = b.negated(Between(start.Format("2006-01-02"), end.Format("2006-01-02")).String())
+	}
+	return b
+}
@@ -0,0 +1,157 @@
+package search
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Query holds the search criteria to perform a search.
+type Query struct {
+	Keywords []string
+	Kind     string
+	Limit    int
+	Order    string
+	Page     int
+	// PageSize caps how many items are requested per page when iterating
+	// results with RepositoriesIter. Defaults to 100 (GitHub's maximum).
+	PageSize   int
+	Qualifiers Qualifiers
+	Sort       string
+	// TextMatches requests the application/vnd.github.v3.text-match+json
+	// preview, which populates TextMatches on matching result items with the
+	// fragments of text that matched the query.
+	TextMatches bool
+}
+
+// Qualifiers are name/value pairs that further narrow down the search.
+// Fields are serialized into the `q` query string using the field's `q`
+// struct tag as the qualifier key, or the lowercased field name when no tag
+// is set, e.g. Stars -> "stars:...", AuthorDate -> "author-date:...".
+// A `del` struct tag can be set on slice fields to join multiple values
+// into a single qualifier instead of repeating the qualifier once per value.
+type Qualifiers struct {
+	Archived      *bool
+	Assignee      string
+	Author        string
+	AuthorDate    string `q:"author-date"`
+	Base          string
+	Closed        string
+	Committer     string
+	CommitterDate string `q:"committer-date"`
+	Created       string
+	Draft         *bool
+	Extension     string
+	Filename      string
+	Followers     string
+	Fork          string
+	Forks         string
+	In            []string
+	Is            []string
+	Label         []string
+	Language      string
+	License       []string
+	Location      string
+	Milestone     string
+	Org           string
+	Path          string
+	Pushed        string
+	Reason        string
+	Repo          string
+	Repos         string `q:"repos"`
+	// Repositories filters topics by the number of repositories tagged with
+	// them, e.g. ">10". Only meaningful for topic search.
+	Repositories string
+	Size         string
+	Stars        string
+	State        string
+	Topic        []string
+	Updated      string
+	User         string
+}
+
+// String serializes a Query into a GitHub search query string, e.g.
+// "cli language:go stars:>100".
+func (q Query) String() string {
+	qualifiers := formatQualifiers(q.Qualifiers)
+	keywords := formatKeywords(q.Keywords)
+	all := append(keywords, qualifiers...)
+	return strings.Join(all, " ")
+}
+
+// Params builds the set of HTTP query parameters that represent this Query,
+// ready to be added to a request's URL.
+func (q Query) Params() map[string]string {
+	params := map[string]string{"q": q.String()}
+	if q.Order != "" {
+		params["order"] = q.Order
+	}
+	if q.Sort != "" {
+		params["sort"] = q.Sort
+	}
+	return params
+}
+
+func formatQualifiers(qs Qualifiers) []string {
+	v := reflect.ValueOf(qs)
+	t := v.Type()
+	var result []string
+	for i := 0; i < v.NumField(); i++ {
+		fieldValue := v.Field(i)
+		fieldName := t.Field(i).Tag.Get("q")
+		if fieldName == "" {
+			fieldName = strings.ToLower(t.Field(i).Name)
+		}
+		switch fieldValue.Kind() {
+		case reflect.Ptr:
+			if !fieldValue.IsNil() {
+				result = append(result, formatQualifier(fieldName, fmt.Sprintf("%v", fieldValue.Elem().Interface())))
+			}
+		case reflect.Slice:
+			if fieldValue.Len() == 0 {
+				continue
+			}
+			values := make([]string, fieldValue.Len())
+			for j := range values {
+				values[j] = fmt.Sprintf("%v", fieldValue.Index(j).Interface())
+			}
+			if del := t.Field(i).Tag.Get("del"); del != "" {
+				result = append(result, formatQualifier(fieldName, strings.Join(values, del)))
+			} else {
+				for _, value := range values {
+					result = append(result, formatQualifier(fieldName, value))
+				}
+			}
+		default:
+			if s := fmt.Sprintf("%v", fieldValue.Interface()); s != "" {
+				result = append(result, formatQualifier(fieldName, s))
+			}
+		}
+	}
+	return result
+}
+
+func formatQualifier(name, value string) string {
+	negate := strings.HasPrefix(value, "-")
+	if negate {
+		value = strings.TrimPrefix(value, "-")
+	}
+	if strings.ContainsAny(value, " \"") {
+		value = fmt.Sprintf("%q", value)
+	}
+	if negate {
+		return fmt.Sprintf("-%s:%s", name, value)
+	}
+	return fmt.Sprintf("%s:%s", name, value)
+}
+
+func formatKeywords(keywords []string) []string {
+	result := make([]string, len(keywords))
+	for i, keyword := range keywords {
+		if strings.ContainsAny(keyword, " \"") {
+			keyword = fmt.Sprintf("%q", keyword)
+		}
+		result[i] = keyword
+	}
+	return result
+}
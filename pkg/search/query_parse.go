@@ -0,0 +1,161 @@
+package search
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ParseQuery parses a GitHub search query string, such as one a user typed
+// into the `gh search` flags or the github.com search box, back into a
+// Query. Keywords and "-qualifier:value"/"NOT qualifier:value" qualifiers
+// are recognized; quoted phrases ("like this") are kept together as a
+// single keyword or qualifier value. A negated boolean qualifier, such as
+// "NOT archived:true", is not accepted and makes ParseQuery return an
+// error. ParseQuery does not set Query.Kind;
+// callers that know which search endpoint the string targets should set it
+// themselves.
+func ParseQuery(s string) (Query, error) {
+	tokens, err := tokenizeQuery(s)
+	if err != nil {
+		return Query{}, err
+	}
+
+	var q Query
+	qualifiersValue := reflect.ValueOf(&q.Qualifiers).Elem()
+	fieldByKey := qualifierFieldsByKey(qualifiersValue.Type())
+
+	for _, tok := range tokens {
+		name, value, negate, isQualifier := splitQualifierToken(tok)
+		if !isQualifier {
+			q.Keywords = append(q.Keywords, unquote(tok))
+			continue
+		}
+		fieldIndex, known := fieldByKey[name]
+		if !known {
+			return Query{}, fmt.Errorf("unrecognized qualifier %q", name)
+		}
+		value = unquote(value)
+		if err := setQualifierField(qualifiersValue.Field(fieldIndex), name, value, negate); err != nil {
+			return Query{}, err
+		}
+	}
+	return q, nil
+}
+
+// qualifierFieldsByKey maps a qualifier's `q=` key (its `q` struct tag, or
+// its lowercased field name when no tag is set) to its field index.
+func qualifierFieldsByKey(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		key := t.Field(i).Tag.Get("q")
+		if key == "" {
+			key = strings.ToLower(t.Field(i).Name)
+		}
+		fields[key] = i
+	}
+	return fields
+}
+
+// setQualifierField assigns value to field, which must be the field of a
+// Qualifiers struct named name. negate reflects a leading "-"/"NOT" on the
+// token the value came from; boolean qualifiers don't have a way to
+// losslessly represent that (formatQualifiers can't re-emit a leading "-"
+// for a *bool), so a negated boolean is rejected rather than silently
+// dropping the negation, which would break Query.String round-tripping.
+func setQualifierField(field reflect.Value, name, value string, negate bool) error {
+	switch field.Kind() {
+	case reflect.Slice:
+		if negate {
+			value = "-" + value
+		}
+		field.Set(reflect.Append(field, reflect.ValueOf(value)))
+	case reflect.Ptr:
+		if negate {
+			return fmt.Errorf("qualifier %q is a boolean and cannot be negated; use %s:false instead", name, name)
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("qualifier %q expects a boolean value, got %q", name, value)
+		}
+		field.Set(reflect.ValueOf(&b))
+	default:
+		if negate {
+			value = "-" + value
+		}
+		field.SetString(value)
+	}
+	return nil
+}
+
+// splitQualifierToken splits a token of the form "name:value" (or
+// "-name:value") into its parts. ok is false for plain keywords, which have
+// no unescaped colon.
+func splitQualifierToken(tok string) (name, value string, negate bool, ok bool) {
+	t := tok
+	if strings.HasPrefix(t, "-") {
+		negate = true
+		t = t[1:]
+	}
+	idx := strings.Index(t, ":")
+	if idx <= 0 {
+		return "", "", false, false
+	}
+	return t[:idx], t[idx+1:], negate, true
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// tokenizeQuery splits a search query string on whitespace, honoring
+// double-quoted phrases ("like this") as a single token, and folds a
+// leading "NOT " onto the token that follows it so that both "-archived:true"
+// and "NOT archived:true" are recognized as negation by the caller.
+func tokenizeQuery(s string) ([]string, error) {
+	var raw []string
+	var cur strings.Builder
+	inQuotes := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"':
+			inQuotes = !inQuotes
+			cur.WriteByte(c)
+		case c == ' ' && !inQuotes:
+			if cur.Len() > 0 {
+				raw = append(raw, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quoted phrase in query %q", s)
+	}
+	if cur.Len() > 0 {
+		raw = append(raw, cur.String())
+	}
+
+	tokens := make([]string, 0, len(raw))
+	for i := 0; i < len(raw); i++ {
+		tok := raw[i]
+		switch strings.ToUpper(tok) {
+		case "NOT":
+			if i+1 < len(raw) {
+				tokens = append(tokens, "-"+raw[i+1])
+				i++
+			}
+		case "AND":
+			// implicit between adjacent tokens; drop the explicit operator
+		default:
+			tokens = append(tokens, tok)
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,132 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query
+	}{
+		{
+			name: "keywords and simple qualifiers",
+			query: Query{
+				Keywords: []string{"cli"},
+				Qualifiers: Qualifiers{
+					Language: "go",
+					Stars:    ">100",
+					Org:      "github",
+				},
+			},
+		},
+		{
+			name: "quoted keyword and negated qualifier",
+			query: Query{
+				Keywords: []string{"hello world"},
+				Qualifiers: Qualifiers{
+					Author: "-mislav",
+				},
+			},
+		},
+		{
+			name: "boolean qualifier",
+			query: Query{
+				Qualifiers: Qualifiers{
+					Archived: boolPtr(true),
+				},
+			},
+		},
+		{
+			name: "repeated slice qualifiers",
+			query: Query{
+				Keywords: []string{"keyword"},
+				Qualifiers: Qualifiers{
+					Topic: []string{"cli", "golang"},
+					Label: []string{"bug", "p1"},
+				},
+			},
+		},
+		{
+			name: "range and date qualifiers",
+			query: Query{
+				Qualifiers: Qualifiers{
+					Forks:   "5..10",
+					Updated: "2021-01-01..2021-06-01",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canon := tt.query.String()
+			parsed, err := ParseQuery(canon)
+			require.NoError(t, err)
+			assert.Equal(t, canon, parsed.String())
+		})
+	}
+}
+
+func TestParseQueryNegation(t *testing.T) {
+	q, err := ParseQuery(`keyword -author:mislav NOT label:wontfix`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"keyword"}, q.Keywords)
+	assert.Equal(t, "-mislav", q.Qualifiers.Author)
+	assert.Equal(t, []string{"-wontfix"}, q.Qualifiers.Label)
+}
+
+func TestParseQueryRejectsNegatedBoolean(t *testing.T) {
+	_, err := ParseQuery(`keyword -archived:true`)
+	require.EqualError(t, err, `qualifier "archived" is a boolean and cannot be negated; use archived:false instead`)
+}
+
+func TestQualifiersBuilder(t *testing.T) {
+	builder := NewQualifiers().
+		Language("go").
+		Stars(Range{Operator: ">", Value: "100"}).
+		Archived(true).
+		UpdatedBetween(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, builder.Err())
+	qualifiers := builder.Build()
+
+	assert.Equal(t, "go", qualifiers.Language)
+	assert.Equal(t, ">100", qualifiers.Stars)
+	require.NotNil(t, qualifiers.Archived)
+	assert.True(t, *qualifiers.Archived)
+	assert.Equal(t, "2021-01-01..2021-06-01", qualifiers.Updated)
+}
+
+func TestQualifiersBuilderRejectsInvalidKindQualifier(t *testing.T) {
+	builder := NewQualifiers().ForKind(KindRepositories).Author("mislav")
+	require.Error(t, builder.Err())
+	assert.Empty(t, builder.Build().Author)
+}
+
+func TestQualifiersBuilderRejectsNegatedBoolean(t *testing.T) {
+	builder := NewQualifiers().Not().Archived(true)
+	require.Error(t, builder.Err())
+	assert.Nil(t, builder.Build().Archived)
+}
+
+func TestQualifiersBuilderNegatesSliceQualifier(t *testing.T) {
+	qualifiers := NewQualifiers().Not().Label("wontfix").Build()
+	assert.Equal(t, []string{"-wontfix"}, qualifiers.Label)
+}
+
+// TestQualifiersBuilderNotDoesNotLeak guards against a Not() meant for one
+// slice qualifier bleeding into the next call once the slice setter forgets
+// to consume negateNext.
+func TestQualifiersBuilderNotDoesNotLeak(t *testing.T) {
+	qualifiers := NewQualifiers().
+		Not().Label("wontfix").
+		Stars(Range{Operator: ">", Value: "100"}).
+		Build()
+
+	assert.Equal(t, []string{"-wontfix"}, qualifiers.Label)
+	assert.Equal(t, ">100", qualifiers.Stars)
+}
@@ -0,0 +1,125 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SearcherOptions customizes how a Searcher behaves when it hits GitHub's
+// search rate limit, which is far tighter than the core API's (30 requests
+// per minute at the time of writing).
+type SearcherOptions struct {
+	// RetryOnRateLimit transparently sleeps until the rate limit resets and
+	// retries the request when true. When false (the default), a rate
+	// limited request fails immediately with a *RateLimitError.
+	RetryOnRateLimit bool
+	// MaxRetries caps how many times a single request is retried after being
+	// rate limited. Defaults to 1 when RetryOnRateLimit is true.
+	MaxRetries int
+	// Clock returns the current time and is used to compute how long to
+	// sleep before retrying. Defaults to time.Now; overridable in tests.
+	Clock func() time.Time
+	// Context bounds how long a retry is allowed to sleep. If set, a wait
+	// for the rate limit to reset is interrupted as soon as Context is
+	// cancelled or its deadline passes, whichever comes first, and the
+	// request fails with Context's error instead of sleeping further.
+	// Defaults to nil, meaning retries sleep for their full duration.
+	Context context.Context
+}
+
+// RateLimitError is returned when a search request is rejected for exceeding
+// GitHub's search rate limit and either retries are disabled or MaxRetries
+// has been exhausted.
+type RateLimitError struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+	Resource  string
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("exceeded rate limit for %s: %d/%d requests remaining until %s", e.Resource, e.Remaining, e.Limit, e.Reset.Format(time.RFC3339))
+}
+
+// rateLimitInfo captures the pieces of a rate-limited response needed to
+// decide how long to sleep before retrying and what to surface in a
+// RateLimitError if retries are disabled or exhausted.
+type rateLimitInfo struct {
+	limit      int
+	remaining  int
+	reset      time.Time
+	resource   string
+	retryAfter time.Duration
+}
+
+func (info rateLimitInfo) asError() error {
+	return &RateLimitError{
+		Limit:     info.limit,
+		Remaining: info.remaining,
+		Reset:     info.reset,
+		Resource:  info.resource,
+	}
+}
+
+// sleepDuration returns how long to wait before retrying, preferring an
+// explicit Retry-After over X-RateLimit-Reset.
+func (info rateLimitInfo) sleepDuration(clock func() time.Time) time.Duration {
+	if info.retryAfter > 0 {
+		return info.retryAfter
+	}
+	if !info.reset.IsZero() {
+		if d := info.reset.Sub(clock()); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+type secondaryRateLimitBody struct {
+	Message string `json:"message"`
+}
+
+// detectRateLimit inspects a search response for GitHub's primary rate limit
+// (403 with X-RateLimit-Remaining: 0, or 429) and its secondary rate limit
+// (422 with a "secondary rate limit" message in the body).
+func detectRateLimit(resp *http.Response, body []byte) (rateLimitInfo, bool) {
+	switch {
+	case resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0":
+		return parseRateLimitHeaders(resp), true
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return parseRateLimitHeaders(resp), true
+	case resp.StatusCode == http.StatusUnprocessableEntity:
+		var secondary secondaryRateLimitBody
+		_ = json.Unmarshal(body, &secondary)
+		if strings.Contains(strings.ToLower(secondary.Message), "secondary rate limit") {
+			return parseRateLimitHeaders(resp), true
+		}
+	}
+	return rateLimitInfo{}, false
+}
+
+func parseRateLimitHeaders(resp *http.Response) rateLimitInfo {
+	info := rateLimitInfo{resource: "search"}
+	if v := resp.Header.Get("X-RateLimit-Limit"); v != "" {
+		info.limit, _ = strconv.Atoi(v)
+	}
+	if v := resp.Header.Get("X-RateLimit-Remaining"); v != "" {
+		info.remaining, _ = strconv.Atoi(v)
+	}
+	if v := resp.Header.Get("X-RateLimit-Reset"); v != "" {
+		if sec, err := strconv.ParseInt(v, 10, 64); err == nil {
+			info.reset = time.Unix(sec, 0)
+		}
+	}
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if sec, err := strconv.Atoi(v); err == nil {
+			info.retryAfter = time.Duration(sec) * time.Second
+		}
+	}
+	return info
+}
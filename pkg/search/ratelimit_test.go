@@ -0,0 +1,94 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var rateLimitQuery = Query{
+	Keywords: []string{"keyword"},
+	Kind:     "repositories",
+	Limit:    1,
+}
+
+var rateLimitValues = url.Values{
+	"page":     []string{"1"},
+	"per_page": []string{"1"},
+	"q":        []string{"keyword"},
+}
+
+func rateLimitedResponse() httpmock.Responder {
+	resp := httpmock.StatusStringResponse(http.StatusForbidden, `{"message":"API rate limit exceeded"}`)
+	resp = httpmock.WithHeader(resp, "X-RateLimit-Limit", "30")
+	resp = httpmock.WithHeader(resp, "X-RateLimit-Remaining", "0")
+	resp = httpmock.WithHeader(resp, "X-RateLimit-Reset", "1")
+	return httpmock.WithHeader(resp, "Content-Type", "application/json")
+}
+
+func TestSearcherRetriesOnRateLimit(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(httpmock.QueryMatcher("GET", "search/repositories", rateLimitValues), rateLimitedResponse())
+	reg.Register(
+		httpmock.QueryMatcher("GET", "search/repositories", rateLimitValues),
+		httpmock.JSONResponse(RepositoriesResult{Items: []Repository{{Name: "test"}}, Total: 1}),
+	)
+
+	client := &http.Client{Transport: reg}
+	searcher := NewSearcherWithOptions(client, "github.com", SearcherOptions{
+		RetryOnRateLimit: true,
+		MaxRetries:       1,
+		Clock:            func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) },
+	})
+
+	result, err := searcher.Repositories(rateLimitQuery)
+	assert.NoError(t, err)
+	assert.Equal(t, []Repository{{Name: "test"}}, result.Items)
+}
+
+func TestSearcherRetryAbortedByContextDeadline(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(httpmock.QueryMatcher("GET", "search/repositories", rateLimitValues), rateLimitedResponse())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	client := &http.Client{Transport: reg}
+	searcher := NewSearcherWithOptions(client, "github.com", SearcherOptions{
+		RetryOnRateLimit: true,
+		MaxRetries:       1,
+		// Far enough before the reset that the retry would otherwise sleep
+		// well past ctx's deadline.
+		Clock:   func() time.Time { return time.Unix(-100000, 0) },
+		Context: ctx,
+	})
+
+	_, err := searcher.Repositories(rateLimitQuery)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSearcherReturnsRateLimitErrorWithoutRetry(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(httpmock.QueryMatcher("GET", "search/repositories", rateLimitValues), rateLimitedResponse())
+
+	client := &http.Client{Transport: reg}
+	searcher := NewSearcher(client, "github.com")
+
+	_, err := searcher.Repositories(rateLimitQuery)
+	require.Error(t, err)
+	var rateLimitErr *RateLimitError
+	require.ErrorAs(t, err, &rateLimitErr)
+	assert.Equal(t, 30, rateLimitErr.Limit)
+	assert.Equal(t, 0, rateLimitErr.Remaining)
+	assert.Equal(t, "search", rateLimitErr.Resource)
+	assert.Equal(t, time.Unix(1, 0), rateLimitErr.Reset)
+}
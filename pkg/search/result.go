@@ -0,0 +1,176 @@
+package search
+
+import "time"
+
+// RepositoriesResult represents the response from the GitHub repositories
+// search endpoint.
+type RepositoriesResult struct {
+	IncompleteResults bool         `json:"incomplete_results"`
+	Items             []Repository `json:"items"`
+	Total             int          `json:"total_count"`
+}
+
+// Repository represents a GitHub repository as returned by the search API.
+type Repository struct {
+	Name            string      `json:"name"`
+	FullName        string      `json:"full_name"`
+	Description     string      `json:"description"`
+	Archived        bool        `json:"archived"`
+	Fork            bool        `json:"fork"`
+	Private         bool        `json:"private"`
+	HasDownloads    bool        `json:"has_downloads"`
+	HasIssues       bool        `json:"has_issues"`
+	HasPages        bool        `json:"has_pages"`
+	HasProjects     bool        `json:"has_projects"`
+	HasWiki         bool        `json:"has_wiki"`
+	ID              int         `json:"id"`
+	Language        string      `json:"language"`
+	License         License     `json:"license"`
+	MasterBranch    string      `json:"master_branch"`
+	Owner           User        `json:"owner"`
+	PushedAt        time.Time   `json:"pushed_at"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+	Size            int         `json:"size"`
+	StargazersCount int         `json:"stargazers_count"`
+	Topics          []string    `json:"topics"`
+	URL             string      `json:"html_url"`
+	Visibility      string      `json:"visibility"`
+	TextMatches     []TextMatch `json:"text_matches"`
+}
+
+// License represents a repository's license.
+type License struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// User represents a GitHub user or organization as returned by the search API.
+type User struct {
+	Login        string `json:"login"`
+	ID           int    `json:"id"`
+	Type         string `json:"type"`
+	Name         string `json:"name"`
+	Company      string `json:"company"`
+	Location     string `json:"location"`
+	Email        string `json:"email"`
+	Followers    int    `json:"followers"`
+	Repositories int    `json:"public_repos"`
+	URL          string `json:"html_url"`
+}
+
+// CommitsResult represents the response from the GitHub commits search
+// endpoint.
+type CommitsResult struct {
+	IncompleteResults bool     `json:"incomplete_results"`
+	Items             []Commit `json:"items"`
+	Total             int      `json:"total_count"`
+}
+
+// Commit represents a single commit as returned by the search API.
+type Commit struct {
+	SHA         string       `json:"sha"`
+	URL         string       `json:"html_url"`
+	Repository  Repository   `json:"repository"`
+	Commit      CommitDetail `json:"commit"`
+	Author      User         `json:"author"`
+	Committer   User         `json:"committer"`
+	TextMatches []TextMatch  `json:"text_matches"`
+}
+
+// CommitDetail holds the Git-level commit data nested under Commit.Commit.
+type CommitDetail struct {
+	Author struct {
+		Name string    `json:"name"`
+		Date time.Time `json:"date"`
+	} `json:"author"`
+	Committer struct {
+		Name string    `json:"name"`
+		Date time.Time `json:"date"`
+	} `json:"committer"`
+	Message string `json:"message"`
+}
+
+// IssuesResult represents the response from the GitHub issues search
+// endpoint. Pull requests are returned through this same endpoint and are
+// distinguished by a non-nil Issue.PullRequest.
+type IssuesResult struct {
+	IncompleteResults bool    `json:"incomplete_results"`
+	Items             []Issue `json:"items"`
+	Total             int     `json:"total_count"`
+}
+
+// Issue represents a GitHub issue or pull request as returned by the search API.
+type Issue struct {
+	Number    int     `json:"number"`
+	Title     string  `json:"title"`
+	Body      string  `json:"body"`
+	State     string  `json:"state"`
+	Reason    string  `json:"state_reason"`
+	Locked    bool    `json:"locked"`
+	Comments  int     `json:"comments"`
+	Labels    []Label `json:"labels"`
+	User      User    `json:"user"`
+	Assignees []User  `json:"assignees"`
+	Milestone *struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ClosedAt    *time.Time `json:"closed_at"`
+	PullRequest *struct {
+		URL string `json:"html_url"`
+	} `json:"pull_request"`
+	URL         string      `json:"html_url"`
+	TextMatches []TextMatch `json:"text_matches"`
+}
+
+// Label represents an issue or pull request label.
+type Label struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// UsersResult represents the response from the GitHub users search endpoint.
+type UsersResult struct {
+	IncompleteResults bool   `json:"incomplete_results"`
+	Items             []User `json:"items"`
+	Total             int    `json:"total_count"`
+}
+
+// TopicsResult represents the response from the GitHub topics search endpoint.
+type TopicsResult struct {
+	IncompleteResults bool    `json:"incomplete_results"`
+	Items             []Topic `json:"items"`
+	Total             int     `json:"total_count"`
+}
+
+// Topic represents a GitHub topic as returned by the search API.
+type Topic struct {
+	Name             string    `json:"name"`
+	DisplayName      string    `json:"display_name"`
+	ShortDescription string    `json:"short_description"`
+	Description      string    `json:"description"`
+	Featured         bool      `json:"featured"`
+	Curated          bool      `json:"curated"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// CodeResult represents the response from the GitHub code search endpoint.
+type CodeResult struct {
+	IncompleteResults bool   `json:"incomplete_results"`
+	Items             []Code `json:"items"`
+	Total             int    `json:"total_count"`
+}
+
+// Code represents a single file match as returned by the code search API.
+type Code struct {
+	Name        string      `json:"name"`
+	Path        string      `json:"path"`
+	SHA         string      `json:"sha"`
+	URL         string      `json:"html_url"`
+	Repository  Repository  `json:"repository"`
+	TextMatches []TextMatch `json:"text_matches"`
+}
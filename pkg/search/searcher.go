@@ -0,0 +1,345 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// The Kind* constants identify the GitHub search endpoint a Query targets.
+// Query.Kind also drives the `type=` parameter used by Searcher.URL.
+const (
+	KindRepositories = "repositories"
+	KindCommits      = "commits"
+	KindIssues       = "issues"
+	KindUsers        = "users"
+	KindTopics       = "topics"
+	KindCode         = "code"
+)
+
+// Searcher finds GitHub resources that match a given query.
+type Searcher interface {
+	Repositories(Query) (RepositoriesResult, error)
+	RepositoriesIter(Query) *RepositoryIterator
+	Commits(Query) (CommitsResult, error)
+	Issues(Query) (IssuesResult, error)
+	Users(Query) (UsersResult, error)
+	Topics(Query) (TopicsResult, error)
+	Code(Query) (CodeResult, error)
+	URL(Query) string
+}
+
+type searcher struct {
+	client *http.Client
+	host   string
+	opts   SearcherOptions
+}
+
+// NewSearcher returns a Searcher that queries the search API of the given
+// host. It does not retry rate limited requests; use NewSearcherWithOptions
+// to enable that.
+func NewSearcher(client *http.Client, host string) Searcher {
+	return NewSearcherWithOptions(client, host, SearcherOptions{})
+}
+
+// NewSearcherWithOptions returns a Searcher that queries the search API of
+// the given host, customized by opts.
+func NewSearcherWithOptions(client *http.Client, host string, opts SearcherOptions) Searcher {
+	if opts.MaxRetries <= 0 {
+		opts.MaxRetries = 1
+	}
+	if opts.Clock == nil {
+		opts.Clock = time.Now
+	}
+	return &searcher{client: client, host: host, opts: opts}
+}
+
+// Repositories is implemented on top of RepositoriesIter so that the two
+// stay behaviorally identical; it simply drains the iterator into a single
+// result.
+func (s *searcher) Repositories(query Query) (RepositoriesResult, error) {
+	query.Kind = KindRepositories
+	it := s.RepositoriesIter(query)
+	defer it.Close()
+	result := RepositoriesResult{}
+	for it.Next() {
+		result.Items = append(result.Items, it.Item())
+	}
+	if err := it.Err(); err != nil {
+		return RepositoriesResult{}, err
+	}
+	result.IncompleteResults = it.incompleteResults
+	result.Total = it.total
+	return result, nil
+}
+
+func (s *searcher) Commits(query Query) (CommitsResult, error) {
+	query.Kind = KindCommits
+	result := CommitsResult{}
+	if err := s.paginate(KindCommits, query, &result); err != nil {
+		return CommitsResult{}, err
+	}
+	return result, nil
+}
+
+func (s *searcher) Issues(query Query) (IssuesResult, error) {
+	query.Kind = KindIssues
+	result := IssuesResult{}
+	if err := s.paginate(KindIssues, query, &result); err != nil {
+		return IssuesResult{}, err
+	}
+	return result, nil
+}
+
+func (s *searcher) Users(query Query) (UsersResult, error) {
+	query.Kind = KindUsers
+	result := UsersResult{}
+	if err := s.paginate(KindUsers, query, &result); err != nil {
+		return UsersResult{}, err
+	}
+	return result, nil
+}
+
+func (s *searcher) Topics(query Query) (TopicsResult, error) {
+	query.Kind = KindTopics
+	result := TopicsResult{}
+	if err := s.paginate(KindTopics, query, &result); err != nil {
+		return TopicsResult{}, err
+	}
+	return result, nil
+}
+
+func (s *searcher) Code(query Query) (CodeResult, error) {
+	query.Kind = KindCode
+	result := CodeResult{}
+	if err := s.paginate(KindCode, query, &result); err != nil {
+		return CodeResult{}, err
+	}
+	return result, nil
+}
+
+// paginate fetches every page of a search result, accumulating IncompleteResults,
+// Total and Items into result, which must be a pointer to a *Result struct such
+// as RepositoriesResult. It stops once query.Limit items have been retrieved, or,
+// if query.Limit is unset (<= 0), once the response no longer advertises a
+// "next" page via the Link header — the same "unlimited" semantics RepositoriesIter
+// uses.
+func (s *searcher) paginate(kind string, query Query, result interface{}) error {
+	v := reflect.ValueOf(result).Elem()
+	items := v.FieldByName("Items")
+	limit := query.Limit
+	retrieved := 0
+	for {
+		if limit > 0 {
+			query.Limit = min(limit-retrieved, maxPerPage)
+		} else {
+			query.Limit = maxPerPage
+		}
+		values, err := s.values(query)
+		if err != nil {
+			return err
+		}
+		page := reflect.New(v.Type())
+		linkHeader, err := s.fetch(kind, values, query, page.Interface())
+		if err != nil {
+			return err
+		}
+		pageVal := page.Elem()
+		v.FieldByName("IncompleteResults").SetBool(pageVal.FieldByName("IncompleteResults").Bool())
+		v.FieldByName("Total").SetInt(pageVal.FieldByName("Total").Int())
+		pageItems := pageVal.FieldByName("Items")
+		items.Set(reflect.AppendSlice(items, pageItems))
+		retrieved += pageItems.Len()
+		nextURL := findNextPage(linkHeader)
+		if nextURL == "" || pageItems.Len() == 0 || (limit > 0 && retrieved >= limit) {
+			break
+		}
+		if query.Page, err = pageFromURL(nextURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// URL builds the GitHub web URL that displays the result of running query
+// in the browser.
+func (s *searcher) URL(query Query) string {
+	params := query.Params()
+	if query.Kind != "" {
+		params["type"] = query.Kind
+	}
+	values := url.Values{}
+	for k, v := range params {
+		values.Set(k, v)
+	}
+	return fmt.Sprintf("https://%s/search?%s", s.host, values.Encode())
+}
+
+const maxPerPage = 100
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (s *searcher) values(query Query) (url.Values, error) {
+	values := url.Values{}
+	for k, v := range query.Params() {
+		values.Set(k, v)
+	}
+	page := query.Page
+	if page == 0 {
+		page = 1
+	}
+	perPage := query.Limit
+	if perPage <= 0 || perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	return values, nil
+}
+
+// previewAccept maps a search Kind to the preview Accept header its endpoint
+// still requires. Kinds not listed here use the stable v3 media type. Keeping
+// this as a lookup table means a future preview graduating to stable is a
+// one-line change.
+var previewAccept = map[string]string{
+	KindCommits: "application/vnd.github.cloak-preview+json",
+	KindTopics:  "application/vnd.github.mercy-preview+json",
+}
+
+// fetch issues a single search request, transparently retrying it if the
+// response indicates GitHub's search rate limit was hit and s.opts allows
+// it, decodes the (successful) response into result and returns the
+// response's Link header for the caller to find the next page, if any.
+func (s *searcher) fetch(kind string, values url.Values, query Query, result interface{}) (string, error) {
+	var linkHeader string
+	for attempt := 0; ; attempt++ {
+		resp, err := s.do(kind, values, query.TextMatches)
+		if err != nil {
+			return "", err
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return "", err
+		}
+		if info, limited := detectRateLimit(resp, body); limited {
+			if s.opts.RetryOnRateLimit && attempt < s.opts.MaxRetries {
+				if err := s.sleep(info.sleepDuration(s.opts.Clock)); err != nil {
+					return "", err
+				}
+				continue
+			}
+			return "", info.asError()
+		}
+		if resp.StatusCode != http.StatusOK {
+			return "", handleHTTPError(resp.StatusCode, body, query)
+		}
+		linkHeader = resp.Header.Get("Link")
+		if err := json.Unmarshal(body, result); err != nil {
+			return "", err
+		}
+		return linkHeader, nil
+	}
+}
+
+// sleep waits for d before a retry, returning early with s.opts.Context's
+// error if it is cancelled or its deadline arrives first. With no Context
+// set it is equivalent to time.Sleep(d).
+func (s *searcher) sleep(d time.Duration) error {
+	ctx := s.opts.Context
+	if ctx == nil {
+		time.Sleep(d)
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *searcher) do(kind string, values url.Values, textMatches bool) (*http.Response, error) {
+	path := fmt.Sprintf("%ssearch/%s", s.restPrefix(), kind)
+	url := fmt.Sprintf("%s?%s", path, values.Encode())
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	accept := "application/vnd.github.v3+json"
+	if preview, ok := previewAccept[kind]; ok {
+		accept = preview
+	}
+	if textMatches {
+		const textMatchAccept = "application/vnd.github.v3.text-match+json"
+		if accept == "application/vnd.github.v3+json" {
+			accept = textMatchAccept
+		} else {
+			accept = accept + ", " + textMatchAccept
+		}
+	}
+	req.Header.Set("Accept", accept)
+	return s.client.Do(req)
+}
+
+func (s *searcher) restPrefix() string {
+	if s.host == "" || s.host == "github.com" {
+		return "https://api.github.com/"
+	}
+	return fmt.Sprintf("https://%s/api/v3/", s.host)
+}
+
+type httpErrorResponse struct {
+	Message string `json:"message"`
+	Errors  []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func handleHTTPError(statusCode int, body []byte, query Query) error {
+	var errResponse httpErrorResponse
+	_ = json.Unmarshal(body, &errResponse)
+	if statusCode == http.StatusUnprocessableEntity && len(errResponse.Errors) > 0 {
+		return fmt.Errorf("Invalid search query %q.\n%s", query.String(), errResponse.Errors[0].Message)
+	}
+	if errResponse.Message != "" {
+		return fmt.Errorf("search failed: %s", errResponse.Message)
+	}
+	return fmt.Errorf("search failed: %s", http.StatusText(statusCode))
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(linkHeader string) string {
+	for _, match := range linkRE.FindAllStringSubmatch(linkHeader, -1) {
+		if match[2] == "next" {
+			return match[1]
+		}
+	}
+	return ""
+}
+
+func pageFromURL(rawURL string) (int, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 0, err
+	}
+	page, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil {
+		return 0, fmt.Errorf("could not parse page number from %q: %w", rawURL, err)
+	}
+	return page, nil
+}
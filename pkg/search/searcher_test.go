@@ -167,6 +167,578 @@ func TestSearcherRepositories(t *testing.T) {
 	}
 }
 
+func TestSearcherCommits(t *testing.T) {
+	commitQuery := Query{
+		Keywords: []string{"keyword"},
+		Kind:     "commits",
+		Limit:    30,
+		Qualifiers: Qualifiers{
+			Author: "mislav",
+		},
+	}
+	values := url.Values{
+		"page":     []string{"1"},
+		"per_page": []string{"30"},
+		"q":        []string{"keyword author:mislav"},
+	}
+
+	tests := []struct {
+		name      string
+		host      string
+		result    CommitsResult
+		httpStubs func(*httpmock.Registry)
+	}{
+		{
+			name: "searches commits",
+			result: CommitsResult{
+				IncompleteResults: false,
+				Items:             []Commit{{SHA: "abc123"}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/commits", values),
+					httpmock.JSONResponse(CommitsResult{
+						IncompleteResults: false,
+						Items:             []Commit{{SHA: "abc123"}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "searches commits for enterprise host",
+			host: "enterprise.com",
+			result: CommitsResult{
+				IncompleteResults: false,
+				Items:             []Commit{{SHA: "abc123"}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "api/v3/search/commits", values),
+					httpmock.JSONResponse(CommitsResult{
+						IncompleteResults: false,
+						Items:             []Commit{{SHA: "abc123"}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "paginates results",
+			result: CommitsResult{
+				IncompleteResults: false,
+				Items:             []Commit{{SHA: "abc123"}, {SHA: "def456"}},
+				Total:             2,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				firstRes := httpmock.JSONResponse(CommitsResult{
+					IncompleteResults: false,
+					Items:             []Commit{{SHA: "abc123"}},
+					Total:             2,
+				})
+				firstRes = httpmock.WithHeader(firstRes, "Link", `<https://api.github.com/search/commits?page=2&per_page=29&q=keyword>; rel="next"`)
+				reg.Register(httpmock.QueryMatcher("GET", "search/commits", values), firstRes)
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/commits", url.Values{
+						"page":     []string{"2"},
+						"per_page": []string{"29"},
+						"q":        []string{"keyword author:mislav"},
+					}),
+					httpmock.JSONResponse(CommitsResult{
+						IncompleteResults: false,
+						Items:             []Commit{{SHA: "def456"}},
+						Total:             2,
+					}),
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+			client := &http.Client{Transport: reg}
+			if tt.host == "" {
+				tt.host = "github.com"
+			}
+			searcher := NewSearcher(client, tt.host)
+			result, err := searcher.Commits(commitQuery)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.result, result)
+		})
+	}
+}
+
+// TestSearcherCommitsNoLimit guards against paginate treating a zero
+// Query.Limit (the default for a caller who just wants every result) as
+// "fetch nothing", the way Repositories/RepositoriesIter already don't.
+func TestSearcherCommitsNoLimit(t *testing.T) {
+	unlimitedQuery := Query{Keywords: []string{"keyword"}, Kind: "commits"}
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	firstRes := httpmock.JSONResponse(CommitsResult{Items: []Commit{{SHA: "abc123"}}, Total: 2})
+	firstRes = httpmock.WithHeader(firstRes, "Link", `<https://api.github.com/search/commits?page=2&per_page=100&q=keyword>; rel="next"`)
+	reg.Register(
+		httpmock.QueryMatcher("GET", "search/commits", url.Values{
+			"page":     []string{"1"},
+			"per_page": []string{"100"},
+			"q":        []string{"keyword"},
+		}),
+		firstRes,
+	)
+	reg.Register(
+		httpmock.QueryMatcher("GET", "search/commits", url.Values{
+			"page":     []string{"2"},
+			"per_page": []string{"100"},
+			"q":        []string{"keyword"},
+		}),
+		httpmock.JSONResponse(CommitsResult{Items: []Commit{{SHA: "def456"}}, Total: 2}),
+	)
+
+	client := &http.Client{Transport: reg}
+	searcher := NewSearcher(client, "github.com")
+	result, err := searcher.Commits(unlimitedQuery)
+	assert.NoError(t, err)
+	assert.Equal(t, []Commit{{SHA: "abc123"}, {SHA: "def456"}}, result.Items)
+}
+
+func TestSearcherIssues(t *testing.T) {
+	issueQuery := Query{
+		Keywords: []string{"keyword"},
+		Kind:     "issues",
+		Limit:    30,
+		Qualifiers: Qualifiers{
+			Is:    []string{"open"},
+			Label: []string{"bug"},
+		},
+	}
+	values := url.Values{
+		"page":     []string{"1"},
+		"per_page": []string{"30"},
+		"q":        []string{"keyword is:open label:bug"},
+	}
+
+	tests := []struct {
+		name      string
+		host      string
+		result    IssuesResult
+		httpStubs func(*httpmock.Registry)
+	}{
+		{
+			name: "searches issues",
+			result: IssuesResult{
+				IncompleteResults: false,
+				Items:             []Issue{{Number: 1}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/issues", values),
+					httpmock.JSONResponse(IssuesResult{
+						IncompleteResults: false,
+						Items:             []Issue{{Number: 1}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "searches issues for enterprise host",
+			host: "enterprise.com",
+			result: IssuesResult{
+				IncompleteResults: false,
+				Items:             []Issue{{Number: 1}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "api/v3/search/issues", values),
+					httpmock.JSONResponse(IssuesResult{
+						IncompleteResults: false,
+						Items:             []Issue{{Number: 1}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "paginates results",
+			result: IssuesResult{
+				IncompleteResults: false,
+				Items:             []Issue{{Number: 1}, {Number: 2}},
+				Total:             2,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				firstRes := httpmock.JSONResponse(IssuesResult{
+					IncompleteResults: false,
+					Items:             []Issue{{Number: 1}},
+					Total:             2,
+				})
+				firstRes = httpmock.WithHeader(firstRes, "Link", `<https://api.github.com/search/issues?page=2&per_page=29&q=keyword>; rel="next"`)
+				reg.Register(httpmock.QueryMatcher("GET", "search/issues", values), firstRes)
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/issues", url.Values{
+						"page":     []string{"2"},
+						"per_page": []string{"29"},
+						"q":        []string{"keyword is:open label:bug"},
+					}),
+					httpmock.JSONResponse(IssuesResult{
+						IncompleteResults: false,
+						Items:             []Issue{{Number: 2}},
+						Total:             2,
+					}),
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+			client := &http.Client{Transport: reg}
+			if tt.host == "" {
+				tt.host = "github.com"
+			}
+			searcher := NewSearcher(client, tt.host)
+			result, err := searcher.Issues(issueQuery)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.result, result)
+		})
+	}
+}
+
+func TestSearcherUsers(t *testing.T) {
+	userQuery := Query{
+		Keywords: []string{"keyword"},
+		Kind:     "users",
+		Limit:    30,
+		Qualifiers: Qualifiers{
+			Location: "amsterdam",
+			Language: "go",
+		},
+	}
+	values := url.Values{
+		"page":     []string{"1"},
+		"per_page": []string{"30"},
+		"q":        []string{"keyword language:go location:amsterdam"},
+	}
+
+	tests := []struct {
+		name      string
+		host      string
+		result    UsersResult
+		httpStubs func(*httpmock.Registry)
+	}{
+		{
+			name: "searches users",
+			result: UsersResult{
+				IncompleteResults: false,
+				Items:             []User{{Login: "mislav"}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/users", values),
+					httpmock.JSONResponse(UsersResult{
+						IncompleteResults: false,
+						Items:             []User{{Login: "mislav"}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "searches users for enterprise host",
+			host: "enterprise.com",
+			result: UsersResult{
+				IncompleteResults: false,
+				Items:             []User{{Login: "mislav"}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "api/v3/search/users", values),
+					httpmock.JSONResponse(UsersResult{
+						IncompleteResults: false,
+						Items:             []User{{Login: "mislav"}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "paginates results",
+			result: UsersResult{
+				IncompleteResults: false,
+				Items:             []User{{Login: "mislav"}, {Login: "vilmibm"}},
+				Total:             2,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				firstRes := httpmock.JSONResponse(UsersResult{
+					IncompleteResults: false,
+					Items:             []User{{Login: "mislav"}},
+					Total:             2,
+				})
+				firstRes = httpmock.WithHeader(firstRes, "Link", `<https://api.github.com/search/users?page=2&per_page=29&q=keyword>; rel="next"`)
+				reg.Register(httpmock.QueryMatcher("GET", "search/users", values), firstRes)
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/users", url.Values{
+						"page":     []string{"2"},
+						"per_page": []string{"29"},
+						"q":        []string{"keyword language:go location:amsterdam"},
+					}),
+					httpmock.JSONResponse(UsersResult{
+						IncompleteResults: false,
+						Items:             []User{{Login: "vilmibm"}},
+						Total:             2,
+					}),
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+			client := &http.Client{Transport: reg}
+			if tt.host == "" {
+				tt.host = "github.com"
+			}
+			searcher := NewSearcher(client, tt.host)
+			result, err := searcher.Users(userQuery)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.result, result)
+		})
+	}
+}
+
+func TestSearcherTopics(t *testing.T) {
+	topicQuery := Query{
+		Keywords: []string{"keyword"},
+		Kind:     "topics",
+		Limit:    30,
+		Qualifiers: Qualifiers{
+			Is:           []string{"featured"},
+			Repositories: ">10",
+		},
+	}
+	values := url.Values{
+		"page":     []string{"1"},
+		"per_page": []string{"30"},
+		"q":        []string{"keyword is:featured repositories:>10"},
+	}
+
+	tests := []struct {
+		name      string
+		host      string
+		result    TopicsResult
+		httpStubs func(*httpmock.Registry)
+	}{
+		{
+			name: "searches topics",
+			result: TopicsResult{
+				IncompleteResults: false,
+				Items:             []Topic{{Name: "cli"}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/topics", values),
+					httpmock.JSONResponse(TopicsResult{
+						IncompleteResults: false,
+						Items:             []Topic{{Name: "cli"}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "searches topics for enterprise host",
+			host: "enterprise.com",
+			result: TopicsResult{
+				IncompleteResults: false,
+				Items:             []Topic{{Name: "cli"}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "api/v3/search/topics", values),
+					httpmock.JSONResponse(TopicsResult{
+						IncompleteResults: false,
+						Items:             []Topic{{Name: "cli"}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "paginates results",
+			result: TopicsResult{
+				IncompleteResults: false,
+				Items:             []Topic{{Name: "cli"}, {Name: "golang"}},
+				Total:             2,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				firstRes := httpmock.JSONResponse(TopicsResult{
+					IncompleteResults: false,
+					Items:             []Topic{{Name: "cli"}},
+					Total:             2,
+				})
+				firstRes = httpmock.WithHeader(firstRes, "Link", `<https://api.github.com/search/topics?page=2&per_page=29&q=keyword>; rel="next"`)
+				reg.Register(httpmock.QueryMatcher("GET", "search/topics", values), firstRes)
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/topics", url.Values{
+						"page":     []string{"2"},
+						"per_page": []string{"29"},
+						"q":        []string{"keyword is:featured repositories:>10"},
+					}),
+					httpmock.JSONResponse(TopicsResult{
+						IncompleteResults: false,
+						Items:             []Topic{{Name: "golang"}},
+						Total:             2,
+					}),
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+			client := &http.Client{Transport: reg}
+			if tt.host == "" {
+				tt.host = "github.com"
+			}
+			searcher := NewSearcher(client, tt.host)
+			result, err := searcher.Topics(topicQuery)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.result, result)
+		})
+	}
+}
+
+func TestSearcherCode(t *testing.T) {
+	codeQuery := Query{
+		Keywords: []string{"keyword"},
+		Kind:     "code",
+		Limit:    30,
+		Qualifiers: Qualifiers{
+			Language:  "go",
+			Extension: "go",
+		},
+	}
+	values := url.Values{
+		"page":     []string{"1"},
+		"per_page": []string{"30"},
+		"q":        []string{"keyword extension:go language:go"},
+	}
+
+	tests := []struct {
+		name      string
+		host      string
+		result    CodeResult
+		httpStubs func(*httpmock.Registry)
+	}{
+		{
+			name: "searches code",
+			result: CodeResult{
+				IncompleteResults: false,
+				Items:             []Code{{Name: "main.go"}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/code", values),
+					httpmock.JSONResponse(CodeResult{
+						IncompleteResults: false,
+						Items:             []Code{{Name: "main.go"}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "searches code for enterprise host",
+			host: "enterprise.com",
+			result: CodeResult{
+				IncompleteResults: false,
+				Items:             []Code{{Name: "main.go"}},
+				Total:             1,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "api/v3/search/code", values),
+					httpmock.JSONResponse(CodeResult{
+						IncompleteResults: false,
+						Items:             []Code{{Name: "main.go"}},
+						Total:             1,
+					}),
+				)
+			},
+		},
+		{
+			name: "paginates results",
+			result: CodeResult{
+				IncompleteResults: false,
+				Items:             []Code{{Name: "main.go"}, {Name: "cmd.go"}},
+				Total:             2,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				firstRes := httpmock.JSONResponse(CodeResult{
+					IncompleteResults: false,
+					Items:             []Code{{Name: "main.go"}},
+					Total:             2,
+				})
+				firstRes = httpmock.WithHeader(firstRes, "Link", `<https://api.github.com/search/code?page=2&per_page=29&q=keyword>; rel="next"`)
+				reg.Register(httpmock.QueryMatcher("GET", "search/code", values), firstRes)
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/code", url.Values{
+						"page":     []string{"2"},
+						"per_page": []string{"29"},
+						"q":        []string{"keyword extension:go language:go"},
+					}),
+					httpmock.JSONResponse(CodeResult{
+						IncompleteResults: false,
+						Items:             []Code{{Name: "cmd.go"}},
+						Total:             2,
+					}),
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+			client := &http.Client{Transport: reg}
+			if tt.host == "" {
+				tt.host = "github.com"
+			}
+			searcher := NewSearcher(client, tt.host)
+			result, err := searcher.Code(codeQuery)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.result, result)
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
 func TestSearcherURL(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -195,4 +767,4 @@ func TestSearcherURL(t *testing.T) {
 			assert.Equal(t, tt.url, searcher.URL(tt.query))
 		})
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,47 @@
+package search
+
+import "strings"
+
+// TextMatch represents a fragment of text that matched the search query. It
+// is only populated when Query.TextMatches is set, which asks GitHub for the
+// application/vnd.github.v3.text-match+json preview.
+type TextMatch struct {
+	Fragment   string           `json:"fragment"`
+	Property   string           `json:"property"`
+	ObjectURL  string           `json:"object_url"`
+	ObjectType string           `json:"object_type"`
+	Matches    []TextMatchIndex `json:"matches"`
+}
+
+// TextMatchIndex identifies one matched substring of a TextMatch's Fragment.
+//
+// Indices are BYTE offsets into Fragment, as GitHub's API documents them, not
+// rune offsets; Highlight accounts for this, but callers indexing into
+// Fragment directly for a multibyte (non-ASCII) fragment must do the same.
+type TextMatchIndex struct {
+	Text    string `json:"text"`
+	Indices [2]int `json:"indices"`
+}
+
+// Highlight wraps every matched substring of tm.Fragment with openTag and
+// closeTag and returns the marked-up result, e.g. Highlight("<b>", "</b>").
+// Matches are applied in the order given; a match that starts before the end
+// of the previous one, or whose indices fall outside Fragment, is skipped.
+func (tm TextMatch) Highlight(openTag, closeTag string) string {
+	fragment := []byte(tm.Fragment)
+	var b strings.Builder
+	last := 0
+	for _, m := range tm.Matches {
+		start, end := m.Indices[0], m.Indices[1]
+		if start < last || end > len(fragment) || start > end {
+			continue
+		}
+		b.Write(fragment[last:start])
+		b.WriteString(openTag)
+		b.Write(fragment[start:end])
+		b.WriteString(closeTag)
+		last = end
+	}
+	b.Write(fragment[last:])
+	return b.String()
+}
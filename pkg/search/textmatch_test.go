@@ -0,0 +1,64 @@
+package search
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearcherCodeTextMatches(t *testing.T) {
+	codeQuery := Query{
+		Keywords:    []string{"keyword"},
+		Kind:        "code",
+		Limit:       30,
+		TextMatches: true,
+	}
+	values := url.Values{
+		"page":     []string{"1"},
+		"per_page": []string{"30"},
+		"q":        []string{"keyword"},
+	}
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.QueryMatcher("GET", "search/code", values),
+		httpmock.JSONResponse(CodeResult{
+			Items: []Code{
+				{
+					Name: "main.go",
+					TextMatches: []TextMatch{
+						{
+							Fragment: "func keyword() {}",
+							Matches: []TextMatchIndex{
+								{Text: "keyword", Indices: [2]int{5, 12}},
+							},
+						},
+					},
+				},
+			},
+			Total: 1,
+		}),
+	)
+
+	client := &http.Client{Transport: reg}
+	searcher := NewSearcher(client, "github.com")
+	result, err := searcher.Code(codeQuery)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(result.Items))
+	assert.Equal(t, "func <em>keyword</em>() {}", result.Items[0].TextMatches[0].Highlight("<em>", "</em>"))
+}
+
+func TestTextMatchHighlightMultibyte(t *testing.T) {
+	tm := TextMatch{
+		Fragment: "café keyword",
+		Matches: []TextMatchIndex{
+			// "café" is 5 bytes (é is 2 bytes in UTF-8), so "keyword" starts at byte 6.
+			{Text: "keyword", Indices: [2]int{6, 13}},
+		},
+	}
+	assert.Equal(t, "café <b>keyword</b>", tm.Highlight("<b>", "</b>"))
+}